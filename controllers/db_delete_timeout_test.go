@@ -0,0 +1,47 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	databasev1beta1 "github.com/openstack-k8s-operators/mariadb-operator/api/v1beta1"
+)
+
+func TestDbDeleteTimeout(t *testing.T) {
+	t.Run("defaults when spec.DbDeleteTimeout is unset", func(t *testing.T) {
+		instance := &databasev1beta1.MariaDBDatabase{}
+		if got := dbDeleteTimeout(instance); got != DefaultDbDeleteTimeout {
+			t.Fatalf("expected DefaultDbDeleteTimeout (%s), got %s", DefaultDbDeleteTimeout, got)
+		}
+	})
+
+	t.Run("honors spec.DbDeleteTimeout when set", func(t *testing.T) {
+		want := 30 * time.Second
+		instance := &databasev1beta1.MariaDBDatabase{
+			Spec: databasev1beta1.MariaDBDatabaseSpec{
+				DbDeleteTimeout: &metav1.Duration{Duration: want},
+			},
+		}
+		if got := dbDeleteTimeout(instance); got != want {
+			t.Fatalf("expected overridden timeout %s, got %s", want, got)
+		}
+	})
+}
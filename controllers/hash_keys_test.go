@@ -0,0 +1,33 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import "testing"
+
+// Regression test for the chunk0-3 bug where a database and its owning user sharing a name (e.g. both
+// "nova") collided in instance.Status.Hash because both loops built their key from the same DbCreateHash
+// prefix.
+func TestDbCreateAndDbGrantHashKeysDoNotCollide(t *testing.T) {
+	const sharedName = "nova"
+
+	createKey := dbCreateHashKey(sharedName)
+	grantKey := dbGrantHashKey(sharedName)
+
+	if createKey == grantKey {
+		t.Fatalf("dbCreateHashKey and dbGrantHashKey collided for name %q: both produced %q", sharedName, createKey)
+	}
+}
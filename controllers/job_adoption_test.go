@@ -0,0 +1,92 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMaybeAdoptJob(t *testing.T) {
+	ctx := context.Background()
+	namespace := "test-ns"
+	jobName := "test-db-create"
+
+	newReconciler := func(objs ...*batchv1.Job) *MariaDBDatabaseReconciler {
+		kclient := fake.NewSimpleClientset()
+		for _, job := range objs {
+			if _, err := kclient.BatchV1().Jobs(namespace).Create(ctx, job, metav1.CreateOptions{}); err != nil {
+				t.Fatalf("failed to seed Job fixture: %v", err)
+			}
+		}
+		return &MariaDBDatabaseReconciler{Kclient: kclient}
+	}
+
+	t.Run("not interrupted never looks at the Job", func(t *testing.T) {
+		r := newReconciler()
+		adopted, err := r.maybeAdoptJob(ctx, false, namespace, jobName)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if adopted {
+			t.Fatal("expected adopted=false when wasInterrupted is false")
+		}
+	})
+
+	t.Run("interrupted but Job was never created", func(t *testing.T) {
+		r := newReconciler()
+		adopted, err := r.maybeAdoptJob(ctx, true, namespace, jobName)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if adopted {
+			t.Fatal("expected adopted=false when the Job does not exist")
+		}
+	})
+
+	t.Run("interrupted and Job is still running", func(t *testing.T) {
+		r := newReconciler(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: namespace},
+			Status:     batchv1.JobStatus{Active: 1},
+		})
+		adopted, err := r.maybeAdoptJob(ctx, true, namespace, jobName)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if adopted {
+			t.Fatal("expected adopted=false for a Job that hasn't succeeded yet")
+		}
+	})
+
+	t.Run("interrupted and Job already succeeded", func(t *testing.T) {
+		r := newReconciler(&batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: namespace},
+			Status:     batchv1.JobStatus{Succeeded: 1},
+		})
+		adopted, err := r.maybeAdoptJob(ctx, true, namespace, jobName)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !adopted {
+			t.Fatal("expected adopted=true for a Job that already succeeded")
+		}
+	})
+}
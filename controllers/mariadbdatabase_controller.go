@@ -18,18 +18,26 @@ package controllers
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
 	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	condition "github.com/openstack-k8s-operators/lib-common/modules/common/condition"
 	helper "github.com/openstack-k8s-operators/lib-common/modules/common/helper"
 	job "github.com/openstack-k8s-operators/lib-common/modules/common/job"
 	databasev1beta1 "github.com/openstack-k8s-operators/mariadb-operator/api/v1beta1"
@@ -39,9 +47,18 @@ import (
 // MariaDBDatabaseReconciler reconciles a MariaDBDatabase object
 type MariaDBDatabaseReconciler struct {
 	client.Client
-	Kclient kubernetes.Interface
-	Log     logr.Logger
-	Scheme  *runtime.Scheme
+	Kclient  kubernetes.Interface
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	// ShutdownCtx is cancelled by main.go once SIGTERM is received so in-progress reconciles can
+	// finish their current checkpoint while new ones back off instead of starting fresh work.
+	ShutdownCtx context.Context
+}
+
+// IsDraining - true once ShutdownCtx has been cancelled, i.e. the manager is gracefully shutting down
+func (r *MariaDBDatabaseReconciler) IsDraining() bool {
+	return r.ShutdownCtx != nil && r.ShutdownCtx.Err() != nil
 }
 
 // GetClient -
@@ -70,6 +87,8 @@ func (r *MariaDBDatabaseReconciler) GetScheme() *runtime.Scheme {
 // +kubebuilder:rbac:groups=mariadb.openstack.org,resources=mariadbs/status,verbs=get;list
 // +kubebuilder:rbac:groups=mariadb.openstack.org,resources=galeras/status,verbs=get;list
 // +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;update;delete;patch
+// +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;update
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile reconcile mariadbdatabase API requests
 func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
@@ -104,13 +123,38 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}()
 
+	// Don't start new work while the manager is draining for a graceful shutdown; let the instance be
+	// picked up again, by this pod or its replacement, once the manager comes back up. Deletes are left
+	// to run to completion since they are short-lived and we don't want to leave stale finalizers behind.
+	if r.IsDraining() && instance.DeletionTimestamp.IsZero() {
+		instance.Status.Conditions.MarkTrue(condition.Type("Terminating"), "MariaDBDatabase reconciliation paused for graceful shutdown")
+		return ctrl.Result{}, nil
+	}
+
+	// If the Terminating condition is still set here, this instance was being worked on by a pod that
+	// got interrupted mid-reconcile by a graceful shutdown. wasInterrupted is carried down to the
+	// Job-creation call sites below so they adopt whatever Job they'd already started, by its
+	// deterministic name, instead of blindly running DoJob again and risking a duplicate
+	// CREATE/GRANT/ALTER job against the backend.
+	wasInterrupted := instance.Status.Conditions.IsTrue(condition.Type("Terminating"))
+	instance.Status.Conditions.Remove(condition.Type("Terminating"))
+
 	// Fetch the Galera or MariaDB instance from which we'll pull the credentials
 	// Note: this will go away when we transition to galera as the db
 	db, dbGalera, dbMariadb, err := r.getDatabaseObject(ctx, instance)
 
-	// if we are being deleted then we have to remove the finalizer from MariaDB/Galera and then remove it from ourselves
+	// if we are being deleted then we have to drop the database/user on the backend, remove the
+	// finalizer from MariaDB/Galera and then remove it from ourselves
 	if !instance.DeletionTimestamp.IsZero() {
-		if err == nil { // so we have MariaDB or Galera to remove finalizer from
+		if err == nil { // so we have MariaDB or Galera to clean up against and remove finalizer from
+			ctrlResult, err := r.ensureDbDropped(ctx, helper, instance, dbGalera, dbMariadb)
+			if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+
 			if controllerutil.RemoveFinalizer(db, fmt.Sprintf("%s-%s", helper.GetFinalizer(), instance.Name)) {
 				err := r.Update(ctx, db)
 				if err != nil {
@@ -118,6 +162,8 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				}
 			}
 		}
+		// if the backing Galera/MariaDB is already gone there is nothing left to drop, so we
+		// just fall through and clean up our own finalizer below.
 
 		// all our external cleanup logic is done so we can remove our own finalizer to signal that we can be deleted.
 		controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
@@ -137,18 +183,8 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	// here we know that MariaDB or Galera exists so add a finalizer to ourselves and to the db CR. Before this point there is no reason to have a finalizer on ourselves as nothing to cleanup.
-	if instance.DeletionTimestamp.IsZero() { // this condition can be removed if you wish as it is always true at this point otherwise we would returned earlier.
-		if controllerutil.AddFinalizer(db, fmt.Sprintf("%s-%s", helper.GetFinalizer(), instance.Name)) {
-			err := r.Update(ctx, db)
-			if err != nil {
-				return ctrl.Result{}, err
-			}
-		}
-
-		if controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
-			// we need to persist this right away
-			return ctrl.Result{}, nil
-		}
+	if ctrlResult, err := r.ensureFinalizers(ctx, instance, db, helper); err != nil || (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, err
 	}
 
 	//
@@ -177,12 +213,27 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		dbContainerImage = dbMariadb.Spec.ContainerImage
 	}
 
-	// Define a new Job object (hostname, password, containerImage)
+	// A CR declaring Databases/Users creates one create-job per database and one grant-job per user
+	// instead of the single implicit database/user derived from the instance itself.
+	if len(instance.Spec.Databases) > 0 || len(instance.Spec.Users) > 0 {
+		return r.reconcileDatabasesAndUsers(ctx, helper, instance, wasInterrupted, dbName, dbSecret, dbContainerImage)
+	}
+
+	// Define a new Job object (hostname, password, containerImage).
 	jobDef, err := mariadb.DbDatabaseJob(instance, dbName, dbSecret, dbContainerImage)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// jobDef.Name is deterministic (derived from the instance). If we were interrupted mid-reconcile by
+	// a graceful shutdown, check whether the Job we'd already started completed in the meantime and
+	// adopt it, skipping DoJob on this reconcile instead of running it with a blank hash, which would
+	// make it think no Job had been created yet and issue a duplicate CREATE DATABASE. Adopting does
+	// not record the Job's hash in Status.Hash.
+	adopted, err := r.maybeAdoptJob(ctx, wasInterrupted, instance.Namespace, jobDef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 	dbCreateHash := instance.Status.Hash[databasev1beta1.DbCreateHash]
 	dbCreateJob := job.NewJob(
 		jobDef,
@@ -191,25 +242,329 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		time.Duration(5)*time.Second,
 		dbCreateHash,
 	)
-	ctrlResult, err := dbCreateJob.DoJob(
-		ctx,
-		helper,
-	)
+	var ctrlResult ctrl.Result
+	if adopted {
+		r.Log.Info(fmt.Sprintf("adopted previously-completed Job %s after restart", jobDef.Name))
+	} else {
+		ctrlResult, err = dbCreateJob.DoJob(
+			ctx,
+			helper,
+		)
+		if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if dbCreateJob.HasChanged() {
+		if instance.Status.Hash == nil {
+			instance.Status.Hash = make(map[string]string)
+		}
+		instance.Status.Hash[databasev1beta1.DbCreateHash] = dbCreateJob.GetHash()
+		r.Log.Info(fmt.Sprintf("Job %s hash added - %s", jobDef.Name, instance.Status.Hash[databasev1beta1.DbCreateHash]))
+	}
+
+	// database creation finished... okay to set to completed
+	instance.Status.Completed = true
+
+	ctrlResult, err = r.reconcileSecretRotation(ctx, helper, instance, wasInterrupted, instance.ObjectMeta.Labels["dbSecret"], dbName, dbSecret, dbContainerImage)
 	if (ctrlResult != ctrl.Result{}) {
 		return ctrlResult, nil
 	}
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-	if dbCreateJob.HasChanged() {
+
+	return ctrl.Result{}, nil
+}
+
+// reconcileSecretRotation compares the ResourceVersion of secretName against the value last recorded for
+// it in instance.Status.RotatedSecretVersions and, if it changed (i.e. the password value was updated),
+// runs an ALTER USER job against the backend so the credential in use matches the one in the secret -
+// without requiring the CR to be deleted and recreated. dbSecret is the admin credential used to
+// authenticate the ALTER USER job itself (the same one DbCreateJob/DbGrantJob use) - secretName's own
+// password was just changed and can't yet authenticate anything. If spec.RotationPolicy.MaxAge is set and
+// more time has passed since the last rotation of secretName than the threshold, a new password is
+// generated into the secret first so that the same change-detection path picks it up. Called both for the
+// CR's own "dbSecret"-labelled secret and, per entry, for each spec.Users[].SecretRef.
+func (r *MariaDBDatabaseReconciler) reconcileSecretRotation(
+	ctx context.Context,
+	helper *helper.Helper,
+	instance *databasev1beta1.MariaDBDatabase,
+	wasInterrupted bool,
+	secretName string,
+	dbHost string,
+	dbSecret string,
+	dbContainerImage string,
+) (ctrl.Result, error) {
+	if secretName == "" {
+		return ctrl.Result{}, nil
+	}
+
+	secret := &corev1.Secret{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: instance.Namespace}, secret)
+	if err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+
+	lastRotatedVersion, seen := instance.Status.RotatedSecretVersions[secretName]
+
+	// Age out against the last time *we* rotated the password, not the secret's CreationTimestamp:
+	// generatePassword's Update call below bumps ResourceVersion but can never change CreationTimestamp
+	// (it's immutable), so comparing against secret creation would keep finding the secret "too old"
+	// and generating a new password on every single reconcile once MaxAge first elapsed. A password is
+	// only generated once a baseline ResourceVersion is recorded, so the change check below sees it.
+	if policy := instance.Spec.RotationPolicy; seen && policy != nil && policy.MaxAge != nil {
+		lastRotation, rotated := instance.Status.LastRotationTime[secret.Name]
+		if !rotated || time.Since(lastRotation.Time) > policy.MaxAge.Duration {
+			if err := r.generatePassword(ctx, secret); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	}
+
+	if !seen {
+		// First time we've ever observed this secret: there's nothing to rotate to yet, so record its
+		// current ResourceVersion as the baseline instead of treating "never recorded" the same as
+		// "changed" - otherwise every brand-new CR would fire a spurious (and, since the password in
+		// the secret hasn't changed, pointless) ALTER USER job on its very first reconcile.
+		if instance.Status.RotatedSecretVersions == nil {
+			instance.Status.RotatedSecretVersions = make(map[string]string)
+		}
+		instance.Status.RotatedSecretVersions[secretName] = secret.ResourceVersion
+		return ctrl.Result{}, nil
+	}
+	if secret.ResourceVersion == lastRotatedVersion {
+		return ctrl.Result{}, nil
+	}
+
+	jobDef, err := mariadb.DbRotateJob(instance, dbHost, dbSecret, secretName, dbContainerImage)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	hashKey := fmt.Sprintf("%s-%s", databasev1beta1.DbRotateHash, secretName)
+	rotateJob := job.NewJob(
+		jobDef,
+		hashKey,
+		false,
+		time.Duration(5)*time.Second,
+		instance.Status.Hash[hashKey],
+	)
+
+	adopted, err := r.maybeAdoptJob(ctx, wasInterrupted, instance.Namespace, jobDef.Name)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if adopted {
+		r.Log.Info(fmt.Sprintf("adopted previously-completed Job %s after restart", jobDef.Name))
+	} else {
+		ctrlResult, err := rotateJob.DoJob(ctx, helper)
+		if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if rotateJob.HasChanged() {
 		if instance.Status.Hash == nil {
 			instance.Status.Hash = make(map[string]string)
 		}
-		instance.Status.Hash[databasev1beta1.DbCreateHash] = dbCreateJob.GetHash()
-		r.Log.Info(fmt.Sprintf("Job %s hash added - %s", jobDef.Name, instance.Status.Hash[databasev1beta1.DbCreateHash]))
+		instance.Status.Hash[hashKey] = rotateJob.GetHash()
+	}
+
+	if instance.Status.RotatedSecretVersions == nil {
+		instance.Status.RotatedSecretVersions = make(map[string]string)
+	}
+	instance.Status.RotatedSecretVersions[secretName] = secret.ResourceVersion
+
+	if instance.Status.LastRotationTime == nil {
+		instance.Status.LastRotationTime = make(map[string]metav1.Time)
+	}
+	instance.Status.LastRotationTime[secret.Name] = metav1.Now()
+	r.Recorder.Eventf(instance, corev1.EventTypeNormal, "SecretRotated", "rotated database credentials from secret %s", secretName)
+
+	return ctrl.Result{}, nil
+}
+
+// generatePassword writes a freshly generated password into the secret's "DatabasePassword" key, which
+// bumps its ResourceVersion so the next reconcile drives it through the same rotation path as a
+// user-initiated secret edit.
+func (r *MariaDBDatabaseReconciler) generatePassword(ctx context.Context, secret *corev1.Secret) error {
+	buf := make([]byte, 24)
+	if _, err := rand.Read(buf); err != nil {
+		return err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data["DatabasePassword"] = []byte(base64.RawURLEncoding.EncodeToString(buf))
+
+	return r.Client.Update(ctx, secret)
+}
+
+// secretToMariaDBDatabase maps a watched Secret to the MariaDBDatabase CRs that reference it, either via
+// their "dbSecret" label (the legacy single-user CR shape) or via a spec.Users[].SecretRef (the
+// multi-database/multi-user CR shape added alongside spec.Databases/spec.Users), so that password
+// changes trigger a reconcile instead of waiting for the next resync.
+func (r *MariaDBDatabaseReconciler) secretToMariaDBDatabase(ctx context.Context, obj client.Object) []reconcile.Request {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	dbList := &databasev1beta1.MariaDBDatabaseList{}
+	if err := r.Client.List(ctx, dbList, client.InNamespace(secret.Namespace)); err != nil {
+		r.Log.Error(err, "unable to list MariaDBDatabases for secret watch")
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, db := range dbList.Items {
+		if db.ObjectMeta.Labels["dbSecret"] == secret.Name {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Name: db.Name, Namespace: db.Namespace},
+			})
+			continue
+		}
+
+		for _, user := range db.Spec.Users {
+			if user.SecretRef == secret.Name {
+				requests = append(requests, reconcile.Request{
+					NamespacedName: types.NamespacedName{Name: db.Name, Namespace: db.Namespace},
+				})
+				break
+			}
+		}
+	}
+
+	return requests
+}
+
+// dbCreateHashKey and dbGrantHashKey build the instance.Status.Hash key for a per-database create job and
+// a per-user grant job, respectively. They use distinct namespaces so that a database and its owning user
+// sharing a name (e.g. both "nova") don't collide in Status.Hash.
+func dbCreateHashKey(databaseName string) string {
+	return fmt.Sprintf("%s-%s", databasev1beta1.DbCreateHash, databaseName)
+}
+
+func dbGrantHashKey(userName string) string {
+	return fmt.Sprintf("%s-%s", databasev1beta1.DbGrantHash, userName)
+}
+
+// reconcileDatabasesAndUsers runs one create-job per entry in instance.Spec.Databases and one grant-job
+// per entry in instance.Spec.Users against the resolved Galera/MariaDB backend, tracking each job's hash
+// under its own key in instance.Status.Hash and surfacing a Ready/not-Ready condition per item so callers
+// can tell which specific database or user is still being provisioned.
+func (r *MariaDBDatabaseReconciler) reconcileDatabasesAndUsers(
+	ctx context.Context,
+	helper *helper.Helper,
+	instance *databasev1beta1.MariaDBDatabase,
+	wasInterrupted bool,
+	dbHost string,
+	dbSecret string,
+	dbContainerImage string,
+) (ctrl.Result, error) {
+	if instance.Status.Hash == nil {
+		instance.Status.Hash = make(map[string]string)
+	}
+
+	for _, database := range instance.Spec.Databases {
+		hashKey := dbCreateHashKey(database.Name)
+		conditionType := condition.Type(fmt.Sprintf("Database%sReady", database.Name))
+
+		jobDef, err := mariadb.DbCreateJob(instance, database, dbHost, dbSecret, dbContainerImage)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		adopted, err := r.maybeAdoptJob(ctx, wasInterrupted, instance.Namespace, jobDef.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		dbCreateJob := job.NewJob(
+			jobDef,
+			hashKey,
+			false,
+			time.Duration(5)*time.Second,
+			instance.Status.Hash[hashKey],
+		)
+		if adopted {
+			r.Log.Info(fmt.Sprintf("adopted previously-completed Job %s after restart", jobDef.Name))
+		} else {
+			ctrlResult, err := dbCreateJob.DoJob(ctx, helper)
+			if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+			if err != nil {
+				instance.Status.Conditions.MarkFalse(conditionType, condition.ErrorReason, condition.SeverityError, err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+		if dbCreateJob.HasChanged() {
+			instance.Status.Hash[hashKey] = dbCreateJob.GetHash()
+			r.Log.Info(fmt.Sprintf("Job %s hash added - %s", jobDef.Name, instance.Status.Hash[hashKey]))
+		}
+		instance.Status.Conditions.MarkTrue(conditionType, condition.ReadyMessage)
+	}
+
+	for _, user := range instance.Spec.Users {
+		// dbGrantHashKey uses a distinct key namespace from the database-create loop above: a database
+		// and its owning user commonly share a name (e.g. both called "nova"), and DbCreateHash/DbGrantHash
+		// would otherwise collide in instance.Status.Hash and cause one of the two jobs to be skipped or
+		// spuriously re-run.
+		hashKey := dbGrantHashKey(user.Name)
+		conditionType := condition.Type(fmt.Sprintf("User%sReady", user.Name))
+
+		jobDef, err := mariadb.DbGrantJob(instance, user, dbHost, dbSecret, dbContainerImage)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+
+		adopted, err := r.maybeAdoptJob(ctx, wasInterrupted, instance.Namespace, jobDef.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		dbGrantJob := job.NewJob(
+			jobDef,
+			hashKey,
+			false,
+			time.Duration(5)*time.Second,
+			instance.Status.Hash[hashKey],
+		)
+		if adopted {
+			r.Log.Info(fmt.Sprintf("adopted previously-completed Job %s after restart", jobDef.Name))
+		} else {
+			ctrlResult, err := dbGrantJob.DoJob(ctx, helper)
+			if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+			if err != nil {
+				instance.Status.Conditions.MarkFalse(conditionType, condition.ErrorReason, condition.SeverityError, err.Error())
+				return ctrl.Result{}, err
+			}
+		}
+		if dbGrantJob.HasChanged() {
+			instance.Status.Hash[hashKey] = dbGrantJob.GetHash()
+			r.Log.Info(fmt.Sprintf("Job %s hash added - %s", jobDef.Name, instance.Status.Hash[hashKey]))
+		}
+
+		ctrlResult, err := r.reconcileSecretRotation(ctx, helper, instance, wasInterrupted, user.SecretRef, dbHost, dbSecret, dbContainerImage)
+		if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+		if err != nil {
+			instance.Status.Conditions.MarkFalse(conditionType, condition.ErrorReason, condition.SeverityError, err.Error())
+			return ctrl.Result{}, err
+		}
+
+		instance.Status.Conditions.MarkTrue(conditionType, condition.ReadyMessage)
 	}
 
-	// database creation finished... okay to set to completed
 	instance.Status.Completed = true
 
 	return ctrl.Result{}, nil
@@ -219,9 +574,129 @@ func (r *MariaDBDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 func (r *MariaDBDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&databasev1beta1.MariaDBDatabase{}).
+		Watches(
+			&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(r.secretToMariaDBDatabase),
+		).
 		Complete(r)
 }
 
+// maybeAdoptJob reports whether a previously-started Job with the given deterministic name has already
+// completed, so the caller can skip re-issuing job.NewJob/DoJob for it. It only looks the Job up when
+// wasInterrupted is true (i.e. we're resuming an instance that was mid-reconcile when the manager was
+// gracefully shut down) - on every other reconcile this is a no-op so we don't add an extra API call to
+// the common path.
+func (r *MariaDBDatabaseReconciler) maybeAdoptJob(ctx context.Context, wasInterrupted bool, namespace string, jobName string) (bool, error) {
+	if !wasInterrupted {
+		return false, nil
+	}
+
+	existing, err := r.Kclient.BatchV1().Jobs(namespace).Get(ctx, jobName, metav1.GetOptions{})
+	if k8s_errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return existing.Status.Succeeded > 0, nil
+}
+
+// DefaultDbDeleteTimeout is used for the db-drop job when the instance doesn't set spec.DbDeleteTimeout.
+const DefaultDbDeleteTimeout = 5 * time.Second
+
+// dbDeleteTimeout resolves the db-drop job's timeout: spec.DbDeleteTimeout if the instance sets one,
+// otherwise DefaultDbDeleteTimeout.
+func dbDeleteTimeout(instance *databasev1beta1.MariaDBDatabase) time.Duration {
+	if instance.Spec.DbDeleteTimeout != nil {
+		return instance.Spec.DbDeleteTimeout.Duration
+	}
+	return DefaultDbDeleteTimeout
+}
+
+// ensureDbDropped - runs a "db-drop" job against the resolved Galera/MariaDB backend that drops the
+// database and its user (DROP DATABASE IF EXISTS / DROP USER IF EXISTS / FLUSH PRIVILEGES), so that the
+// resources created by the db-create job are actually released when the MariaDBDatabase CR is deleted.
+// It is safe to call on every reconcile of a deleting instance: the underlying job is idempotent and its
+// hash is tracked in instance.Status.Hash so it is only re-run when its definition changes. The job's
+// timeout defaults to DefaultDbDeleteTimeout but can be overridden per-instance via spec.DbDeleteTimeout.
+func (r *MariaDBDatabaseReconciler) ensureDbDropped(
+	ctx context.Context,
+	helper *helper.Helper,
+	instance *databasev1beta1.MariaDBDatabase,
+	dbGalera *databasev1beta1.Galera,
+	dbMariadb *databasev1beta1.MariaDB,
+) (ctrl.Result, error) {
+	dbName, dbSecret, dbContainerImage, err := r.getDbDetails(dbGalera, dbMariadb)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	jobDef, err := mariadb.DbDropJob(instance, dbName, dbSecret, dbContainerImage)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	dbDeleteHash := instance.Status.Hash[databasev1beta1.DbDeleteHash]
+	dbDeleteJob := job.NewJob(
+		jobDef,
+		databasev1beta1.DbDeleteHash,
+		false,
+		dbDeleteTimeout(instance),
+		dbDeleteHash,
+	)
+	ctrlResult, err := dbDeleteJob.DoJob(
+		ctx,
+		helper,
+	)
+	if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if dbDeleteJob.HasChanged() {
+		if instance.Status.Hash == nil {
+			instance.Status.Hash = make(map[string]string)
+		}
+		instance.Status.Hash[databasev1beta1.DbDeleteHash] = dbDeleteJob.GetHash()
+		r.Log.Info(fmt.Sprintf("Job %s hash added - %s", jobDef.Name, instance.Status.Hash[databasev1beta1.DbDeleteHash]))
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// ensureFinalizers adds our finalizer to the backing Galera/MariaDB object and to the instance itself,
+// persisting the db update immediately and requesting an explicit requeue once the instance finalizer is
+// added so that the remainder of Reconcile always runs against an object we know has it recorded, rather
+// than relying on the next watch-triggered reconcile to pick up the change.
+func (r *MariaDBDatabaseReconciler) ensureFinalizers(ctx context.Context, instance *databasev1beta1.MariaDBDatabase, db client.Object, helper *helper.Helper) (ctrl.Result, error) {
+	if controllerutil.AddFinalizer(db, fmt.Sprintf("%s-%s", helper.GetFinalizer(), instance.Name)) {
+		if err := r.Update(ctx, db); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// getDbDetails - returns the hostname, secret and container image of the resolved Galera/MariaDB backend
+func (r *MariaDBDatabaseReconciler) getDbDetails(dbGalera *databasev1beta1.Galera, dbMariadb *databasev1beta1.MariaDB) (string, string, string, error) {
+	if dbGalera != nil {
+		return dbGalera.Name, dbGalera.Spec.Secret, dbGalera.Spec.ContainerImage, nil
+	}
+	if dbMariadb != nil {
+		return dbMariadb.Name, dbMariadb.Spec.Secret, dbMariadb.Spec.ContainerImage, nil
+	}
+
+	return "", "", "", fmt.Errorf("neither Galera nor MariaDB backend resolved")
+}
+
 // getDatabaseObject - returns either a Galera or MariaDB object (and an associated client.Object interface)
 func (r *MariaDBDatabaseReconciler) getDatabaseObject(ctx context.Context, instance *databasev1beta1.MariaDBDatabase) (client.Object, *databasev1beta1.Galera, *databasev1beta1.MariaDB, error) {
 	dbGalera := &databasev1beta1.Galera{
@@ -0,0 +1,64 @@
+/*
+
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	databasev1beta1 "github.com/openstack-k8s-operators/mariadb-operator/api/v1beta1"
+)
+
+var _ = Describe("MariaDBDatabase", func() {
+	var namespace string
+
+	BeforeEach(func() {
+		ns := &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{GenerateName: "mariadbdatabase-test-"},
+		}
+		Expect(k8sClient.Create(ctx, ns)).To(Succeed())
+		namespace = ns.Name
+	})
+
+	// Create+delete in quick succession: Reconcile handles deletion before adding any finalizer, and
+	// while the backing Galera/MariaDB does not exist it requeues without adding one, so the object is
+	// removed cleanly with no finalizer ever added.
+	It("removes cleanly when deleted immediately after creation, before any backing Galera/MariaDB exists", func() {
+		instance := &databasev1beta1.MariaDBDatabase{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-db",
+				Namespace: namespace,
+				Labels: map[string]string{
+					"dbName": "does-not-exist",
+				},
+			},
+		}
+
+		Expect(k8sClient.Create(ctx, instance)).To(Succeed())
+		Expect(k8sClient.Delete(ctx, instance)).To(Succeed())
+
+		Eventually(func(g Gomega) {
+			err := k8sClient.Get(ctx, client.ObjectKeyFromObject(instance), &databasev1beta1.MariaDBDatabase{})
+			g.Expect(k8s_errors.IsNotFound(err)).To(BeTrue())
+		}).Should(Succeed())
+	})
+})